@@ -0,0 +1,92 @@
+// cbc_shim solves an MPS file with CBC and writes the solution in the
+// varName;i;j;value format expected back by the OPL models (solver = "cbc").
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// varRe splits a CBC column name such as "z_3_7" or "a_12" back into its
+// OPL variable name and index tuple.
+var varRe = regexp.MustCompile(`^([a-zA-Z]+)_(\d+)(?:_(\d+))?$`)
+
+func main() {
+	mpsPath := flag.String("mps", "", "path to the MPS file exported by cplex.exportModel")
+	outPath := flag.String("out", "cbc_solution.csv", "path to write the CBC solution in varName;i;j;value format")
+	cbcBin := flag.String("cbc", "cbc", "path to the cbc executable")
+	flag.Parse()
+
+	if *mpsPath == "" {
+		log.Fatal("cbc_shim: -mps is required")
+	}
+
+	solPath := *mpsPath + ".sol"
+	cmd := exec.Command(*cbcBin, *mpsPath, "solve", "solution", solPath)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		log.Fatalf("cbc_shim: cbc failed: %v", err)
+	}
+
+	if err := convert(solPath, *outPath); err != nil {
+		log.Fatalf("cbc_shim: %v", err)
+	}
+}
+
+// convert reads CBC's native solution format (one "Optimal - objective value
+// X" header line followed by "index name value reduced-cost" rows) and
+// rewrites it as the CSV the OPL models read back in.
+func convert(solPath, outPath string) error {
+	in, err := os.Open(solPath)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", solPath, err)
+	}
+	defer in.Close()
+
+	out, err := os.Create(outPath)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", outPath, err)
+	}
+	defer out.Close()
+
+	w := bufio.NewWriter(out)
+	defer w.Flush()
+
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+		if strings.HasPrefix(line, "Optimal") || strings.HasPrefix(line, "Infeasible") {
+			if v, err := strconv.ParseFloat(fields[len(fields)-1], 64); err == nil {
+				fmt.Fprintf(w, "obj;%g\n", v)
+			}
+			continue
+		}
+		if len(fields) < 3 {
+			continue
+		}
+		name := fields[1]
+		value := fields[2]
+		m := varRe.FindStringSubmatch(name)
+		if m == nil {
+			continue
+		}
+		if m[3] != "" {
+			fmt.Fprintf(w, "%s;%s;%s;%s\n", m[1], m[2], m[3], value)
+		} else {
+			fmt.Fprintf(w, "%s;%s;%s\n", m[1], m[2], value)
+		}
+	}
+	return scanner.Err()
+}
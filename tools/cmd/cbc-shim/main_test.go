@@ -0,0 +1,69 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVarRe(t *testing.T) {
+	cases := []struct {
+		name        string
+		wantVar     string
+		wantI       string
+		wantJ       string
+		wantNoMatch bool
+	}{
+		{name: "a_12", wantVar: "a", wantI: "12"},
+		{name: "z_3_7", wantVar: "z", wantI: "3", wantJ: "7"},
+		{name: "obj", wantNoMatch: true},
+	}
+	for _, c := range cases {
+		m := varRe.FindStringSubmatch(c.name)
+		if c.wantNoMatch {
+			if m != nil {
+				t.Errorf("varRe matched %q, want no match", c.name)
+			}
+			continue
+		}
+		if m == nil {
+			t.Fatalf("varRe didn't match %q", c.name)
+		}
+		if m[1] != c.wantVar || m[2] != c.wantI || m[3] != c.wantJ {
+			t.Errorf("varRe(%q) = %v, want [%s %s %s]", c.name, m[1:], c.wantVar, c.wantI, c.wantJ)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	dir := t.TempDir()
+	solPath := filepath.Join(dir, "model.mps.sol")
+	outPath := filepath.Join(dir, "cbc_solution.csv")
+
+	sol := "Optimal - objective value 123.45\n" +
+		"   0 a_0              1.5            0\n" +
+		"   1 z_3_7              1            0\n" +
+		"   2 unknownvar          9            0\n"
+	if err := os.WriteFile(solPath, []byte(sol), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := convert(solPath, outPath); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(out)
+	for _, want := range []string{"obj;123.45", "a;0;1.5", "z;3;7;1"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output %q missing %q", got, want)
+		}
+	}
+	if strings.Contains(got, "unknownvar") {
+		t.Errorf("output %q should drop the unmatched column, got it anyway", got)
+	}
+}
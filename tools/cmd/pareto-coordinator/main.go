@@ -0,0 +1,264 @@
+// pareto-coordinator shards the (epsilon_DIO, epsilon_WIP, epsilon_Emis) grid
+// from the multi-objective model across N oplrun worker processes, streams
+// their result blocks to its own stdout with each line tagged by cell id, and
+// checkpoints per-cell progress so a killed sweep resumes without recomputing
+// solved cells. It writes no Pareto archive and does not filter out dominated
+// or duplicate points.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+type cellStatus string
+
+const (
+	pending    cellStatus = "pending"
+	running    cellStatus = "running"
+	done       cellStatus = "done"
+	infeasible cellStatus = "infeasible"
+)
+
+// cell identifies one grid point and its checkpointed status.
+type cell struct {
+	ID     int        `json:"id"`
+	IDio   int        `json:"iDio"`
+	IWip   int        `json:"iWip"`
+	IEmis  int        `json:"iEmis"`
+	Status cellStatus `json:"status"`
+}
+
+// gridBounds mirrors the payoff-table ranges the .mod computes for itself
+// (bDIO.hi/bWIP.hi/bEmis.hi and the AUGMECON_G-derived step sizes), so the
+// coordinator can reproduce the same `hi - i*step` epsilon values per cell
+// without re-deriving the payoff table in Go.
+type gridBounds struct {
+	DioHi, DioStep   float64
+	WipHi, WipStep   float64
+	EmisHi, EmisStep float64
+}
+
+// epsilonsFor returns the (epsilon_DIO, epsilon_WIP, epsilon_Emis) triple for
+// a cell, using the same `hi - i*step` formula as computePayoffTable's caller
+// in the .mod file.
+func (b gridBounds) epsilonsFor(c *cell) (dio, wip, emis float64) {
+	return b.DioHi - float64(c.IDio)*b.DioStep,
+		b.WipHi - float64(c.IWip)*b.WipStep,
+		b.EmisHi - float64(c.IEmis)*b.EmisStep
+}
+
+func main() {
+	modPath := flag.String("mod", "", "path to the .mod file to run per cell (required unless -dry-run)")
+	gridG := flag.Int("g", 4, "grid intervals per secondary objective (g+1 points each), matching AUGMECON_G in the model")
+	workers := flag.Int("workers", 4, "number of worker processes to shard the grid across")
+	stateFile := flag.String("state", "pareto_state.json", "checkpoint file tracking pending/running/done/infeasible cells")
+	outDir := flag.String("out", "pareto_workdirs", "base directory; each worker gets outDir/worker-<n>")
+	dryRun := flag.Bool("dry-run", false, "only print the shard plan, run nothing")
+	dioHi := flag.Float64("dio-hi", 0, "payoff-table max of DIO, i.e. the .mod's bDIO.hi")
+	dioStep := flag.Float64("dio-step", 0, "payoff-table DIO step, i.e. the .mod's stepDIO (r_DIO/AUGMECON_G)")
+	wipHi := flag.Float64("wip-hi", 0, "payoff-table max of WIP, i.e. the .mod's bWIP.hi")
+	wipStep := flag.Float64("wip-step", 0, "payoff-table WIP step, i.e. the .mod's stepWIP (r_WIP/AUGMECON_G)")
+	emisHi := flag.Float64("emis-hi", 0, "payoff-table max of Emis, i.e. the .mod's bEmis.hi")
+	emisStep := flag.Float64("emis-step", 0, "payoff-table Emis step, i.e. the .mod's stepEmis (r_Emis/AUGMECON_G)")
+	flag.Parse()
+
+	cells := loadOrInitState(*stateFile, *gridG)
+
+	if *dryRun {
+		printShardPlan(cells, *workers)
+		return
+	}
+	if *modPath == "" {
+		log.Fatal("pareto-coordinator: -mod is required (unless -dry-run)")
+	}
+	if *dioHi == 0 || *dioStep == 0 || *wipHi == 0 || *wipStep == 0 || *emisHi == 0 || *emisStep == 0 {
+		log.Fatal("pareto-coordinator: -dio-hi, -dio-step, -wip-hi, -wip-step, -emis-hi and -emis-step are all required (unless -dry-run); " +
+			"run the .mod's computePayoffTable() once and pass its bDIO/bWIP/bEmis.hi and hi-lo/AUGMECON_G steps")
+	}
+
+	bounds := gridBounds{
+		DioHi: *dioHi, DioStep: *dioStep,
+		WipHi: *wipHi, WipStep: *wipStep,
+		EmisHi: *emisHi, EmisStep: *emisStep,
+	}
+	runCells(cells, *modPath, *workers, *outDir, *stateFile, bounds)
+}
+
+// loadOrInitState resumes a checkpoint if present, otherwise builds the full
+// (g+1)^3 grid with every cell pending.
+func loadOrInitState(stateFile string, g int) []*cell {
+	if data, err := os.ReadFile(stateFile); err == nil {
+		var cells []*cell
+		if err := json.Unmarshal(data, &cells); err == nil {
+			for _, c := range cells {
+				if c.Status == running {
+					c.Status = pending // a previous run was killed mid-cell
+				}
+			}
+			return cells
+		}
+	}
+	var cells []*cell
+	id := 0
+	for iDio := 0; iDio <= g; iDio++ {
+		for iWip := 0; iWip <= g; iWip++ {
+			for iEmis := 0; iEmis <= g; iEmis++ {
+				cells = append(cells, &cell{ID: id, IDio: iDio, IWip: iWip, IEmis: iEmis, Status: pending})
+				id++
+			}
+		}
+	}
+	return cells
+}
+
+func saveState(stateFile string, cells []*cell) {
+	data, err := json.MarshalIndent(cells, "", "  ")
+	if err != nil {
+		log.Printf("pareto-coordinator: marshal state: %v", err)
+		return
+	}
+	if err := os.WriteFile(stateFile, data, 0o644); err != nil {
+		log.Printf("pareto-coordinator: write state: %v", err)
+	}
+}
+
+// printShardPlan shows how the pending cells would be distributed across
+// workers without launching anything.
+func printShardPlan(cells []*cell, workers int) {
+	var pendingCells []*cell
+	for _, c := range cells {
+		if c.Status == pending {
+			pendingCells = append(pendingCells, c)
+		}
+	}
+	shards := shard(pendingCells, workers)
+	for w, s := range shards {
+		ids := make([]string, len(s))
+		for i, c := range s {
+			ids[i] = fmt.Sprintf("%d", c.ID)
+		}
+		fmt.Printf("worker-%d: %d cells [%s]\n", w, len(s), strings.Join(ids, ","))
+	}
+}
+
+// shard splits cells round-robin into `workers` roughly-equal chunks (cell i
+// goes to worker i % workers). Each coordinator run shards the pending cells
+// from the checkpoint afresh, so resuming with a different -workers count
+// redistributes them across the new count rather than sticking to the old
+// shards; the worker count does not change while a run is going.
+func shard(cells []*cell, workers int) [][]*cell {
+	if workers <= 0 {
+		workers = 1
+	}
+	shards := make([][]*cell, workers)
+	for i, c := range cells {
+		w := i % workers
+		shards[w] = append(shards[w], c)
+	}
+	return shards
+}
+
+// runCells launches `workers` goroutines, each driving oplrun for its shard
+// of pending cells in its own working directory, and checkpoints after every
+// cell so the sweep can resume if killed.
+func runCells(cells []*cell, modPath string, workers int, outDir, stateFile string, bounds gridBounds) {
+	var pendingCells []*cell
+	for _, c := range cells {
+		if c.Status == pending {
+			pendingCells = append(pendingCells, c)
+		}
+	}
+	shards := shard(pendingCells, workers)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for w, s := range shards {
+		wg.Add(1)
+		go func(workerID int, myCells []*cell) {
+			defer wg.Done()
+			workDir := filepath.Join(outDir, fmt.Sprintf("worker-%d", workerID))
+			if err := os.MkdirAll(workDir, 0o755); err != nil {
+				log.Printf("pareto-coordinator: worker %d: mkdir %s: %v", workerID, workDir, err)
+				return
+			}
+			for _, c := range myCells {
+				mu.Lock()
+				c.Status = running
+				saveState(stateFile, cells)
+				mu.Unlock()
+
+				ok := runOneCell(modPath, workDir, c, bounds)
+
+				mu.Lock()
+				if ok {
+					c.Status = done
+				} else {
+					c.Status = infeasible
+				}
+				saveState(stateFile, cells)
+				mu.Unlock()
+			}
+		}(w, s)
+	}
+	wg.Wait()
+}
+
+// runOneCell invokes oplrun for a single grid cell in its worker's directory,
+// pinning PARETO_MODE/obj_primary to a Cost-primary single solve, and passing
+// the real per-cell epsilon_DIO/epsilon_WIP/epsilon_Emis (mirroring the .mod's
+// augmecon2 driver's bDIO.hi - iDIO*stepDIO math). With PARETO_MODE=single the
+// .mod zeroes the augmentation term, so each cell is a plain epsilon-constraint
+// solve rather than the augmented one. It streams the xxxx-delimited result block
+// back to stdout and reports false when the cell's run comes back
+// infeasible.
+func runOneCell(modPath, workDir string, c *cell, bounds gridBounds) bool {
+	epsDIO, epsWIP, epsEmis := bounds.epsilonsFor(c)
+	cmd := exec.Command("oplrun",
+		"-D", "PARETO_MODE=single",
+		"-D", "obj_primary=1", // Cost primary, matching the .mod's own augmecon2 driver
+		"-D", fmt.Sprintf("epsilon_DIO=%g", epsDIO),
+		"-D", fmt.Sprintf("epsilon_WIP=%g", epsWIP),
+		"-D", fmt.Sprintf("epsilon_Emis=%g", epsEmis),
+		modPath)
+	cmd.Dir = workDir
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		log.Printf("cell %d: stdout pipe: %v", c.ID, err)
+		return false
+	}
+	if err := cmd.Start(); err != nil {
+		log.Printf("cell %d: start oplrun: %v", c.ID, err)
+		return false
+	}
+
+	feasible := true
+	scanner := bufio.NewScanner(stdout)
+	inBlock := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "xxxx" {
+			inBlock = !inBlock
+			continue
+		}
+		if inBlock {
+			fmt.Printf("[cell %d] %s\n", c.ID, line)
+			if strings.Contains(line, "infeasible") {
+				feasible = false
+			}
+		}
+	}
+	if err := cmd.Wait(); err != nil {
+		log.Printf("cell %d: oplrun exited with error: %v", c.ID, err)
+		return false
+	}
+	return feasible
+}
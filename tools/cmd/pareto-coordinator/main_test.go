@@ -0,0 +1,50 @@
+package main
+
+import "testing"
+
+func TestShard(t *testing.T) {
+	cells := make([]*cell, 5)
+	for i := range cells {
+		cells[i] = &cell{ID: i}
+	}
+
+	shards := shard(cells, 2)
+	if len(shards) != 2 {
+		t.Fatalf("shard count = %d, want 2", len(shards))
+	}
+	total := 0
+	for _, s := range shards {
+		total += len(s)
+	}
+	if total != len(cells) {
+		t.Fatalf("sharded %d cells, want %d", total, len(cells))
+	}
+}
+
+func TestShardZeroWorkers(t *testing.T) {
+	cells := []*cell{{ID: 0}, {ID: 1}}
+	shards := shard(cells, 0)
+	if len(shards) != 1 {
+		t.Fatalf("shard count = %d, want 1 (clamped from 0)", len(shards))
+	}
+}
+
+func TestGridBoundsEpsilonsFor(t *testing.T) {
+	b := gridBounds{
+		DioHi: 100, DioStep: 10,
+		WipHi: 200, WipStep: 20,
+		EmisHi: 300, EmisStep: 30,
+	}
+	c := &cell{IDio: 2, IWip: 1, IEmis: 3}
+
+	dio, wip, emis := b.epsilonsFor(c)
+	if dio != 80 {
+		t.Errorf("dio = %v, want 80", dio)
+	}
+	if wip != 180 {
+		t.Errorf("wip = %v, want 180", wip)
+	}
+	if emis != 210 {
+		t.Errorf("emis = %v, want 210", emis)
+	}
+}